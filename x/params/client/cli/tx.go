@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsclient "github.com/tabilabs/tabi-cosmos/x/params/client"
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// NewSubmitParamChangeProposalTxCmd returns a CLI command handler for
+// submitting a parameter change proposal alongside an initial deposit.
+func NewSubmitParamChangeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a parameter change proposal",
+		Long: strings.TrimSpace(
+			`Submit a parameter change proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubmitParamChangeProposalTxCmd(cmd, args, false)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSubmitExpeditedParamChangeProposalTxCmd returns a CLI command handler
+// for submitting a parameter change proposal marked as expedited. This SDK
+// version's gov module has no expedited voting track of its own (that is a
+// cosmos-sdk v0.50+ feature built on CometBFT, and this chain still runs on
+// Tendermint Core), so "expedited" here is enforced only by
+// ParameterChangeProposal.ValidateBasic, which rejects proposals touching a
+// non-expeditable subspace (e.g. baseapp or staking); voting period and
+// threshold are whatever the chain's regular gov params are. It reads the
+// same JSON file format as param-change.
+func NewSubmitExpeditedParamChangeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-expedited-param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an expedited parameter change proposal",
+		Long: strings.TrimSpace(
+			`Submit a parameter change proposal marked as expedited, along with an
+initial deposit. The proposal details must be supplied via a JSON file.
+Proposals touching a non-expeditable subspace are rejected.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubmitParamChangeProposalTxCmd(cmd, args, true)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSubmitCancelParamChangeProposalTxCmd returns a CLI command handler for
+// submitting a CancelParameterChangeProposal that cancels a previously
+// queued, not-yet-applied ParameterChangeProposal by its queue ID (the ID
+// emitted when the change was queued, not its governance proposal ID).
+func NewSubmitCancelParamChangeProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to cancel a queued parameter change proposal",
+		Long: strings.TrimSpace(
+			`Submit a proposal to cancel a parameter change proposal that was
+scheduled for a future height and has not yet taken effect, along with an
+initial deposit. The proposal details must be supplied via a JSON file.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalJSON, err := paramsclient.ParseCancelParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := proposal.NewCancelParameterChangeProposal(
+				proposalJSON.Title, proposalJSON.Description, proposalJSON.ProposalID,
+			)
+
+			from := clientCtx.GetFromAddress()
+
+			deposit, err := sdk.ParseCoinsNormalized(proposalJSON.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func runSubmitParamChangeProposalTxCmd(cmd *cobra.Command, args []string, isExpedited bool) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	proposalJSON, err := paramsclient.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+	if err != nil {
+		return err
+	}
+
+	content := proposal.NewScheduledParameterChangeProposal(
+		proposalJSON.Title, proposalJSON.Description, proposalJSON.Changes.ToParamChanges(), isExpedited, proposalJSON.Height,
+	)
+
+	from := clientCtx.GetFromAddress()
+
+	deposit, err := sdk.ParseCoinsNormalized(proposalJSON.Deposit)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err != nil {
+		return fmt.Errorf("invalid message: %w", err)
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// ParamChangeProposalJSON defines a ParameterChangeProposal with a deposit used
+// to parse parameter change proposals from a JSON file.
+type ParamChangeProposalJSON struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	Changes     ParamChangesJSON `json:"changes" yaml:"changes"`
+	Deposit     string           `json:"deposit" yaml:"deposit"`
+
+	// Height, if above the block height at which the proposal passes,
+	// schedules the changes to apply at that block height instead of
+	// immediately; zero or an already-reached height applies them immediately.
+	Height int64 `json:"height,omitempty" yaml:"height,omitempty"`
+}
+
+// CancelParamChangeProposalJSON defines a CancelParameterChangeProposal with
+// a deposit used to parse cancel proposals from a JSON file.
+type CancelParamChangeProposalJSON struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+	Deposit     string `json:"deposit" yaml:"deposit"`
+}
+
+// ParamChangeJSON defines a parameter change used in JSON input. It differs
+// from ParamChange in that it can contain a Subkey.
+type ParamChangeJSON struct {
+	Subspace string          `json:"subspace" yaml:"subspace"`
+	Key      string          `json:"key" yaml:"key"`
+	Subkey   string          `json:"subkey,omitempty" yaml:"subkey,omitempty"`
+	Value    json.RawMessage `json:"value" yaml:"value"`
+}
+
+// ParamChangesJSON defines a slice of ParamChangeJSON objects which can be
+// converted to a slice of ParamChange objects.
+type ParamChangesJSON []ParamChangeJSON
+
+// ToParamChanges converts a slice of ParamChangeJSON objects to a slice of
+// ParamChange objects.
+func (pc ParamChangesJSON) ToParamChanges() []proposal.ParamChange {
+	res := make([]proposal.ParamChange, len(pc))
+
+	for i, pc := range pc {
+		res[i] = proposal.NewParamChangeWithSubkey(pc.Subspace, pc.Key, pc.Subkey, string(pc.Value))
+	}
+
+	return res
+}
+
+// ParseParamChangeProposalJSON reads and parses a ParamChangeProposalJSON from
+// a file.
+func ParseParamChangeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ParamChangeProposalJSON, error) {
+	proposal := ParamChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelParamChangeProposalJSON reads and parses a
+// CancelParamChangeProposalJSON from a file.
+func ParseCancelParamChangeProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (CancelParamChangeProposalJSON, error) {
+	proposal := CancelParamChangeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
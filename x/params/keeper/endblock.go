@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamChangeQueue returns the queue of scheduled parameter changes, backed
+// by this keeper's own store key.
+func (k Keeper) ParamChangeQueue(ctx sdk.Context) ParamChangeQueue {
+	return NewParamChangeQueue(ctx.KVStore(k.key))
+}
+
+// EndBlock applies and dequeues every scheduled parameter change whose
+// target height has been reached. The params module's EndBlock calls this.
+func (k Keeper) EndBlock(ctx sdk.Context) error {
+	return ProcessParamChangeQueue(ctx, k, k.ParamChangeQueue(ctx), k.cdc)
+}
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// ParamChangeQueueNextIDKey stores the next ID Schedule will assign.
+var ParamChangeQueueNextIDKey = []byte{0x00}
+
+// ParamChangeQueuePrefix is the store prefix under which queued
+// ParameterChangeProposals are kept until their scheduled height arrives or
+// they are cancelled.
+var ParamChangeQueuePrefix = []byte{0x01}
+
+// ParamChangeQueue holds ParameterChangeProposals that were scheduled for a
+// future height (Height > 0 in the proposal) instead of taking effect
+// immediately, giving a follow-up CancelParameterChangeProposal a window to
+// cancel them first. Entries are keyed by a queue ID local to this queue
+// (NOT the gov proposal ID of the proposal that scheduled them, which the
+// content handler is never given) - Schedule assigns and returns this ID,
+// and it's what a CancelParameterChangeProposal.ProposalId must reference.
+type ParamChangeQueue struct {
+	store sdk.KVStore
+}
+
+// NewParamChangeQueue constructs a ParamChangeQueue backed by store.
+func NewParamChangeQueue(store sdk.KVStore) ParamChangeQueue {
+	return ParamChangeQueue{store: store}
+}
+
+func paramChangeQueueKey(id uint64) []byte {
+	key := make([]byte, len(ParamChangeQueuePrefix)+8)
+	copy(key, ParamChangeQueuePrefix)
+	binary.BigEndian.PutUint64(key[len(ParamChangeQueuePrefix):], id)
+	return key
+}
+
+func (q ParamChangeQueue) nextID() uint64 {
+	// IDs start at 1: CancelParameterChangeProposal.ValidateBasic rejects 0.
+	id := uint64(1)
+	if bz := q.store.Get(ParamChangeQueueNextIDKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+
+	next := make([]byte, 8)
+	binary.BigEndian.PutUint64(next, id+1)
+	q.store.Set(ParamChangeQueueNextIDKey, next)
+
+	return id
+}
+
+// Schedule queues p and returns the queue ID assigned to it, so it can be
+// applied once the chain reaches p.Height or cancelled before then.
+func (q ParamChangeQueue) Schedule(cdc codec.BinaryCodec, p *proposal.ParameterChangeProposal) uint64 {
+	id := q.nextID()
+	q.store.Set(paramChangeQueueKey(id), cdc.MustMarshal(p))
+
+	return id
+}
+
+// Get returns the queued proposal for id, if any is still queued.
+func (q ParamChangeQueue) Get(cdc codec.BinaryCodec, id uint64) (*proposal.ParameterChangeProposal, bool) {
+	bz := q.store.Get(paramChangeQueueKey(id))
+	if bz == nil {
+		return nil, false
+	}
+
+	var p proposal.ParameterChangeProposal
+	cdc.MustUnmarshal(bz, &p)
+
+	return &p, true
+}
+
+// Cancel removes the queued proposal identified by id and reports whether a
+// queued proposal was found and removed.
+func (q ParamChangeQueue) Cancel(id uint64) bool {
+	key := paramChangeQueueKey(id)
+	if !q.store.Has(key) {
+		return false
+	}
+
+	q.store.Delete(key)
+
+	return true
+}
+
+// DueAt returns the queue IDs of every queued proposal scheduled to apply at
+// exactly height.
+func (q ParamChangeQueue) DueAt(cdc codec.BinaryCodec, height int64) []uint64 {
+	var due []uint64
+
+	it := sdk.KVStorePrefixIterator(q.store, ParamChangeQueuePrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var p proposal.ParameterChangeProposal
+		cdc.MustUnmarshal(it.Value(), &p)
+
+		if p.Height == height {
+			due = append(due, binary.BigEndian.Uint64(it.Key()[len(ParamChangeQueuePrefix):]))
+		}
+	}
+
+	return due
+}
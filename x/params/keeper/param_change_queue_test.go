@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tabilabs/tabi-cosmos/x/params/keeper"
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+func newTestParamChangeQueue(t *testing.T) (keeper.ParamChangeQueue, codec.BinaryCodec) {
+	storeKey := sdk.NewKVStoreKey("params")
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, nil)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	return keeper.NewParamChangeQueue(ctx.KVStore(storeKey)), cdc
+}
+
+func TestParamChangeQueueScheduleGetCancel(t *testing.T) {
+	q, cdc := newTestParamChangeQueue(t)
+
+	p := &proposal.ParameterChangeProposal{Title: "t", Description: "d", Height: 100}
+
+	id := q.Schedule(cdc, p)
+
+	got, ok := q.Get(cdc, id)
+	require.True(t, ok)
+	require.Equal(t, p.Height, got.Height)
+
+	require.True(t, q.Cancel(id))
+	_, ok = q.Get(cdc, id)
+	require.False(t, ok)
+
+	require.False(t, q.Cancel(id))
+}
+
+func TestParamChangeQueueDueAt(t *testing.T) {
+	q, cdc := newTestParamChangeQueue(t)
+
+	due := q.Schedule(cdc, &proposal.ParameterChangeProposal{Title: "due", Height: 100})
+	notDue := q.Schedule(cdc, &proposal.ParameterChangeProposal{Title: "not-due", Height: 200})
+
+	ids := q.DueAt(cdc, 100)
+	require.Equal(t, []uint64{due}, ids)
+
+	_, ok := q.Get(cdc, notDue)
+	require.True(t, ok)
+}
+
+func TestParamChangeQueueAssignsDistinctIDs(t *testing.T) {
+	q, cdc := newTestParamChangeQueue(t)
+
+	first := q.Schedule(cdc, &proposal.ParameterChangeProposal{Title: "first", Height: 1})
+	second := q.Schedule(cdc, &proposal.ParameterChangeProposal{Title: "second", Height: 1})
+
+	require.NotEqual(t, first, second)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	paramtypes "github.com/tabilabs/tabi-cosmos/x/params/types"
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// SubspaceRegistry looks up a module's registered Subspace by name. The
+// params keeper that owns the subspace registry implements this.
+type SubspaceRegistry interface {
+	GetSubspace(name string) (paramtypes.Subspace, bool)
+}
+
+// HandleParameterChangeProposal is called once a ParameterChangeProposal
+// passes. A proposal scheduled for a future height (Height > BlockHeight) is
+// queued instead of applied immediately; anything already due is applied
+// right away. gov's Handler signature does not carry the proposal's gov ID,
+// so a queued proposal is tracked under a queue ID assigned by q.Schedule and
+// emitted as an event - that is the ID a follow-up
+// CancelParameterChangeProposal must reference, not the gov proposal ID.
+func HandleParameterChangeProposal(ctx sdk.Context, registry SubspaceRegistry, q ParamChangeQueue, cdc codec.BinaryCodec, p *proposal.ParameterChangeProposal) error {
+	if p.Height > ctx.BlockHeight() {
+		queueID := q.Schedule(cdc, p)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				paramtypes.EventTypeParamChangeQueued,
+				sdk.NewAttribute(paramtypes.AttributeKeyQueueID, fmt.Sprintf("%d", queueID)),
+			),
+		)
+
+		return nil
+	}
+
+	return applyParamChanges(ctx, registry, p.Changes)
+}
+
+// HandleCancelParameterChangeProposal is called once a
+// CancelParameterChangeProposal passes. It removes the referenced proposal
+// from the queue before it takes effect.
+func HandleCancelParameterChangeProposal(q ParamChangeQueue, p *proposal.CancelParameterChangeProposal) error {
+	if !q.Cancel(p.ProposalId) {
+		return fmt.Errorf("no queued parameter change proposal with id %d", p.ProposalId)
+	}
+
+	return nil
+}
+
+// ProcessParamChangeQueue applies and dequeues every queued proposal whose
+// scheduled height has arrived. Call this from EndBlock.
+func ProcessParamChangeQueue(ctx sdk.Context, registry SubspaceRegistry, q ParamChangeQueue, cdc codec.BinaryCodec) error {
+	for _, queueID := range q.DueAt(cdc, ctx.BlockHeight()) {
+		p, ok := q.Get(cdc, queueID)
+		if !ok {
+			continue
+		}
+
+		if err := applyParamChanges(ctx, registry, p.Changes); err != nil {
+			return err
+		}
+
+		q.Cancel(queueID)
+	}
+
+	return nil
+}
+
+func applyParamChanges(ctx sdk.Context, registry SubspaceRegistry, changes []proposal.ParamChange) error {
+	for _, pc := range changes {
+		ss, ok := registry.GetSubspace(pc.Subspace)
+		if !ok {
+			return fmt.Errorf("unknown subspace: %s", pc.Subspace)
+		}
+
+		if err := proposal.ApplyParamChange(ctx, ss, pc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// NewParamChangeProposalHandler returns a gov router Handler dispatching
+// ParameterChangeProposal and CancelParameterChangeProposal content. Register
+// it with the app's gov router alongside the other proposal handlers:
+//
+//	govRouter.AddRoute(proposal.RouterKey, keeper.NewParamChangeProposalHandler(paramsKeeper))
+func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *proposal.ParameterChangeProposal:
+			return HandleParameterChangeProposal(ctx, k, k.ParamChangeQueue(ctx), k.cdc, c)
+		case *proposal.CancelParameterChangeProposal:
+			return HandleCancelParameterChangeProposal(k.ParamChangeQueue(ctx), c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package params
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlock applies any scheduled parameter changes that have reached their
+// target height and dequeues them.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if err := am.keeper.EndBlock(ctx); err != nil {
+		panic(err)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
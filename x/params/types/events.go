@@ -0,0 +1,8 @@
+package types
+
+// Parameter change queue event types and attribute keys.
+const (
+	EventTypeParamChangeQueued = "param_change_queued"
+
+	AttributeKeyQueueID = "queue_id"
+)
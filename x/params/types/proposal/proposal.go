@@ -8,24 +8,47 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramtypes "github.com/tabilabs/tabi-cosmos/x/params/types"
 )
 
 const (
 	// ProposalTypeChange defines the type for a ParameterChangeProposal
 	ProposalTypeChange = "ParameterChange"
+
+	// ProposalTypeExpeditedChange defines the type for a ParameterChangeProposal
+	// submitted on the expedited governance track.
+	ProposalTypeExpeditedChange = "ExpeditedParameterChange"
 )
 
+// nonExpeditableSubspaces lists subspaces that are too consensus-critical to
+// be fast-tracked through the expedited governance track.
+var nonExpeditableSubspaces = map[string]bool{
+	"baseapp": true,
+	"staking": true,
+}
+
 // Assert ParameterChangeProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &ParameterChangeProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeChange)
 	govtypes.RegisterProposalTypeCodec(&ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal")
+	govtypes.RegisterProposalType(ProposalTypeExpeditedChange)
 }
 
 func NewParameterChangeProposal(title, description string, changes []ParamChange, isExpedited bool) *ParameterChangeProposal {
-	return &ParameterChangeProposal{title, description, changes, isExpedited}
+	return NewScheduledParameterChangeProposal(title, description, changes, isExpedited, 0)
+}
+
+// NewScheduledParameterChangeProposal creates a new ParameterChangeProposal
+// whose changes apply at the given height instead of immediately once the
+// proposal passes. A height of 0 applies the changes immediately, matching
+// NewParameterChangeProposal. A scheduled proposal can be cancelled before it
+// takes effect with a follow-up CancelParameterChangeProposal.
+func NewScheduledParameterChangeProposal(title, description string, changes []ParamChange, isExpedited bool, height int64) *ParameterChangeProposal {
+	return &ParameterChangeProposal{Title: title, Description: description, Changes: changes, IsExpedited: isExpedited, Height: height}
 }
 
 // GetTitle returns the title of a parameter change proposal.
@@ -37,8 +60,15 @@ func (pcp *ParameterChangeProposal) GetDescription() string { return pcp.Descrip
 // ProposalRoute returns the routing key of a parameter change proposal.
 func (pcp *ParameterChangeProposal) ProposalRoute() string { return RouterKey }
 
-// ProposalType returns the type of a parameter change proposal.
-func (pcp *ParameterChangeProposal) ProposalType() string { return ProposalTypeChange }
+// ProposalType returns the type of a parameter change proposal. Proposals
+// with IsExpedited set use the expedited governance track.
+func (pcp *ParameterChangeProposal) ProposalType() string {
+	if pcp.IsExpedited {
+		return ProposalTypeExpeditedChange
+	}
+
+	return ProposalTypeChange
+}
 
 // ValidateBasic validates the parameter change proposal
 func (pcp *ParameterChangeProposal) ValidateBasic() error {
@@ -47,6 +77,14 @@ func (pcp *ParameterChangeProposal) ValidateBasic() error {
 		return err
 	}
 
+	if pcp.IsExpedited {
+		for _, pc := range pcp.Changes {
+			if nonExpeditableSubspaces[pc.Subspace] {
+				return fmt.Errorf("subspace %s cannot be changed through an expedited parameter change proposal", pc.Subspace)
+			}
+		}
+	}
+
 	return ValidateChanges(pcp.Changes)
 }
 
@@ -57,22 +95,104 @@ func (pcp ParameterChangeProposal) String() string {
 	b.WriteString(fmt.Sprintf(`Parameter Change Proposal:
   Title:       %s
   Description: %s
+  Height:      %d
   Changes:
-`, pcp.Title, pcp.Description))
+`, pcp.Title, pcp.Description, pcp.Height))
 
 	for _, pc := range pcp.Changes {
 		b.WriteString(fmt.Sprintf(`    Param Change:
       Subspace: %s
       Key:      %s
+      Subkey:   %s
       Value:    %X
-`, pc.Subspace, pc.Key, pc.Value))
+`, pc.Subspace, pc.Key, pc.Subkey, pc.Value))
 	}
 
 	return b.String()
 }
 
+// ProposalTypeCancelChange defines the type for a
+// CancelParameterChangeProposal.
+const ProposalTypeCancelChange = "CancelParameterChange"
+
+// Assert CancelParameterChangeProposal implements govtypes.Content at
+// compile-time
+var _ govtypes.Content = &CancelParameterChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCancelChange)
+	govtypes.RegisterProposalTypeCodec(&CancelParameterChangeProposal{}, "cosmos-sdk/CancelParameterChangeProposal")
+}
+
+// NewCancelParameterChangeProposal creates a new CancelParameterChangeProposal
+// cancelling the queued ParameterChangeProposal identified by proposalID.
+func NewCancelParameterChangeProposal(title, description string, proposalID uint64) *CancelParameterChangeProposal {
+	return &CancelParameterChangeProposal{Title: title, Description: description, ProposalId: proposalID}
+}
+
+// GetTitle returns the title of a cancel parameter change proposal.
+func (cpcp *CancelParameterChangeProposal) GetTitle() string { return cpcp.Title }
+
+// GetDescription returns the description of a cancel parameter change proposal.
+func (cpcp *CancelParameterChangeProposal) GetDescription() string { return cpcp.Description }
+
+// ProposalRoute returns the routing key of a cancel parameter change proposal.
+func (cpcp *CancelParameterChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel parameter change proposal.
+func (cpcp *CancelParameterChangeProposal) ProposalType() string { return ProposalTypeCancelChange }
+
+// ValidateBasic validates the cancel parameter change proposal. The
+// referenced proposal ID itself (whether it exists, is still queued, and has
+// not yet reached its scheduled height) is checked at execution time by the
+// params keeper, which owns the queue of scheduled changes.
+func (cpcp *CancelParameterChangeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(cpcp)
+	if err != nil {
+		return err
+	}
+
+	if cpcp.ProposalId == 0 {
+		return fmt.Errorf("cancel parameter change proposal must reference a proposal id")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cpcp CancelParameterChangeProposal) String() string {
+	return fmt.Sprintf(`Cancel Parameter Change Proposal:
+  Title:       %s
+  Description: %s
+  ProposalId:  %d
+`, cpcp.Title, cpcp.Description, cpcp.ProposalId)
+}
+
+// NewParamChange creates a new ParamChange that replaces the whole value of
+// the parameter addressed by subspace and key.
 func NewParamChange(subspace, key, value string) ParamChange {
-	return ParamChange{subspace, key, value}
+	return NewParamChangeWithSubkey(subspace, key, "", value)
+}
+
+// NewParamChangeWithSubkey creates a new ParamChange scoped to subkey, a
+// single nested field of a struct-valued parameter. The subspace merges
+// value into that field instead of overwriting the whole parameter; see
+// ApplyParamChange and Subspace.UpdateWithSubkey.
+func NewParamChangeWithSubkey(subspace, key, subkey, value string) ParamChange {
+	return ParamChange{Subspace: subspace, Key: key, Subkey: subkey, Value: value}
+}
+
+// ApplyParamChange applies pc against ss. This is what the params keeper's
+// proposal handler calls for each change in a passed ParameterChangeProposal:
+// a subkey-scoped change merges into the existing value via
+// Subspace.UpdateWithSubkey, an unscoped change replaces it wholesale via
+// Subspace.Update.
+func ApplyParamChange(ctx sdk.Context, ss paramtypes.Subspace, pc ParamChange) error {
+	if pc.Subkey == "" {
+		return ss.Update(ctx, []byte(pc.Key), []byte(pc.Value))
+	}
+
+	return ss.UpdateWithSubkey(ctx, []byte(pc.Key), pc.Subkey, []byte(pc.Value))
 }
 
 // String implements the Stringer interface.
@@ -103,16 +223,81 @@ func ValidateChanges(changes []ParamChange) error {
 				return err
 			}
 		}
+		if err := validateParamType(pc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// paramValidators holds the validators registered via RegisterParamValidator,
+// keyed by subspace and key.
+var paramValidators = make(map[string]map[string]paramValidator)
+
+// paramValidator decodes a raw parameter value and checks it against the
+// module-provided validation function.
+type paramValidator struct {
+	decode   func(value string) (interface{}, error)
+	validate func(interface{}) error
+}
+
+// RegisterParamValidator registers a decode and validate function for the
+// parameter addressed by subspace and key. Modules call this (typically from
+// an init function alongside their ParamSetPairs) so that ValidateChanges can
+// type-check a proposed value against a zero value of the target type before
+// the proposal is even accepted for deposit, rather than only failing at
+// execution.
+func RegisterParamValidator(subspace, key string, decodeFn func(value string) (interface{}, error), validateFn func(interface{}) error) {
+	subspaceValidators, ok := paramValidators[subspace]
+	if !ok {
+		subspaceValidators = make(map[string]paramValidator)
+		paramValidators[subspace] = subspaceValidators
+	}
+
+	subspaceValidators[key] = paramValidator{decode: decodeFn, validate: validateFn}
+}
+
+// validateParamType looks up a registered validator for pc and, if one
+// exists, decodes and validates pc.Value against it. Subspaces and keys with
+// no registered validator are left to the existing non-emptiness checks.
+func validateParamType(pc ParamChange) error {
+	if pc.Subkey != "" {
+		// pc.Value here is only the subkey-addressed field, not a value of
+		// the whole registered type, so the whole-value decoder doesn't
+		// apply; subkey-scoped changes are left to the subspace merge at
+		// execution time.
+		return nil
+	}
+
+	v, ok := paramValidators[pc.Subspace][pc.Key]
+	if !ok {
+		return nil
+	}
+
+	decoded, err := v.decode(pc.Value)
+	if err != nil {
+		return fmt.Errorf("failed to decode value for subspace %s, key %s: %w", pc.Subspace, pc.Key, err)
+	}
+
+	if err := v.validate(decoded); err != nil {
+		return fmt.Errorf("invalid value for subspace %s, key %s: %w", pc.Subspace, pc.Key, err)
 	}
 
 	return nil
 }
 
+
+// SupportedConsensusPubKeyTypes lists the consensus pubkey type names this
+// app accepts for validators. A ValidatorParams change naming any other type
+// is rejected up front in ValidateBasic instead of only surfacing once it
+// takes effect at EndBlock.
+var SupportedConsensusPubKeyTypes = []string{types.ABCIPubKeyTypeEd25519}
+
 func verifyConsensusParamsUsingDefault(changes []ParamChange) error {
 	// Start with a default (valid) set of parameters, and update based on proposal then check
 	defaultCP := types.DefaultConsensusParams()
 	for _, change := range changes {
-		// Note: BlockParams seems to be the only support ConsensusParams available for modifying with proposal
 		switch change.Key {
 		case "BlockParams":
 			blockParams := types.DefaultBlockParams()
@@ -121,6 +306,30 @@ func verifyConsensusParamsUsingDefault(changes []ParamChange) error {
 				return err
 			}
 			defaultCP.Block = blockParams
+		case "EvidenceParams":
+			evidenceParams := types.DefaultEvidenceParams()
+			err := json.Unmarshal([]byte(change.Value), &evidenceParams)
+			if err != nil {
+				return err
+			}
+			defaultCP.Evidence = evidenceParams
+		case "ValidatorParams":
+			validatorParams := types.DefaultValidatorParams()
+			err := json.Unmarshal([]byte(change.Value), &validatorParams)
+			if err != nil {
+				return err
+			}
+			if err := verifySupportedPubKeyTypes(validatorParams.PubKeyTypes); err != nil {
+				return err
+			}
+			defaultCP.Validator = validatorParams
+		case "VersionParams":
+			versionParams := types.DefaultVersionParams()
+			err := json.Unmarshal([]byte(change.Value), &versionParams)
+			if err != nil {
+				return err
+			}
+			defaultCP.Version = versionParams
 		}
 	}
 	if err := defaultCP.ValidateConsensusParams(); err != nil {
@@ -128,3 +337,21 @@ func verifyConsensusParamsUsingDefault(changes []ParamChange) error {
 	}
 	return nil
 }
+
+// verifySupportedPubKeyTypes returns an error if pubKeyTypes names a
+// consensus pubkey type this app does not support.
+func verifySupportedPubKeyTypes(pubKeyTypes []string) error {
+	for _, pkt := range pubKeyTypes {
+		var supported bool
+		for _, s := range SupportedConsensusPubKeyTypes {
+			if pkt == s {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("unsupported consensus pubkey type: %s", pkt)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package proposal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateChangesRejectsBadlyTypedRegisteredValue(t *testing.T) {
+	RegisterParamValidator("test", "Count", func(value string) (interface{}, error) {
+		var v uint32
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}, func(interface{}) error {
+		return nil
+	})
+
+	err := ValidateChanges([]ParamChange{NewParamChange("test", "Count", "1.5")})
+	require.Error(t, err)
+
+	err = ValidateChanges([]ParamChange{NewParamChange("test", "Count", "5")})
+	require.NoError(t, err)
+}
+
+func TestValidateChangesRejectsUnsupportedConsensusPubKeyType(t *testing.T) {
+	change := NewParamChange("baseapp", "ValidatorParams", `{"pub_key_types":["bls"]}`)
+
+	err := ValidateChanges([]ParamChange{change})
+	require.Error(t, err)
+}
+
+func TestValidateChangesAcceptsSupportedConsensusPubKeyType(t *testing.T) {
+	change := NewParamChange("baseapp", "ValidatorParams", `{"pub_key_types":["ed25519"]}`)
+
+	err := ValidateChanges([]ParamChange{change})
+	require.NoError(t, err)
+}
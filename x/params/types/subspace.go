@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpdateWithSubkey merges value into the field addressed by subkey within
+// the existing value stored for key, instead of replacing it wholesale.
+// Like Update, the merged value is decoded into the parameter's registered
+// type and run through its ValidatorFn before being persisted, so a
+// subkey-scoped change is validated exactly as a whole-value change would
+// be. An empty subkey is equivalent to Update, preserving the pre-subkey
+// whole-value replace behavior.
+func (s Subspace) UpdateWithSubkey(ctx sdk.Context, key []byte, subkey string, value []byte) error {
+	if subkey == "" {
+		return s.Update(ctx, key, value)
+	}
+
+	merged, err := mergeParamSubkey(s.GetRaw(ctx, key), subkey, value)
+	if err != nil {
+		return fmt.Errorf("cannot merge subkey %q into %s/%s: %w", subkey, s.Name(), key, err)
+	}
+
+	return s.Update(ctx, key, merged)
+}
+
+// mergeParamSubkey returns currentValue with the field named subkey replaced
+// by value. currentValue must unmarshal as a JSON object; fields other than
+// subkey are carried over byte-for-byte (via json.RawMessage) so siblings
+// are never re-encoded, only the targeted field changes.
+func mergeParamSubkey(currentValue []byte, subkey string, value []byte) ([]byte, error) {
+	fields := make(map[string]json.RawMessage)
+
+	if len(currentValue) > 0 {
+		if err := json.Unmarshal(currentValue, &fields); err != nil {
+			return nil, fmt.Errorf("existing value is not a JSON object: %w", err)
+		}
+	}
+
+	fields[subkey] = json.RawMessage(value)
+
+	return json.Marshal(fields)
+}
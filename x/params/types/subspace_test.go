@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeParamSubkey(t *testing.T) {
+	current := []byte(`{"max_validators":100,"unbonding_time":"100s"}`)
+
+	merged, err := mergeParamSubkey(current, "max_validators", []byte("50"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"max_validators":50,"unbonding_time":"100s"}`, string(merged))
+}
+
+func TestMergeParamSubkeyEmptyCurrentValue(t *testing.T) {
+	merged, err := mergeParamSubkey(nil, "max_validators", []byte("50"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"max_validators":50}`, string(merged))
+}
+
+func TestMergeParamSubkeyRejectsNonObjectCurrentValue(t *testing.T) {
+	_, err := mergeParamSubkey([]byte(`"not an object"`), "max_validators", []byte("50"))
+	require.Error(t, err)
+}
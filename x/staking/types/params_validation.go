@@ -0,0 +1,33 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	paramproposal "github.com/tabilabs/tabi-cosmos/x/params/types/proposal"
+)
+
+// Parameter keys matching the ones used in the staking ParamSetPairs.
+const (
+	paramsSubspace   = ModuleName
+	keyMaxValidators = "MaxValidators"
+)
+
+// init registers a pre-flight validator for MaxValidators so a malformed
+// governance JSON value (e.g. setting MaxValidators to "1.5") is rejected in
+// ValidateBasic instead of only failing once the proposal passes and is
+// applied.
+func init() {
+	paramproposal.RegisterParamValidator(paramsSubspace, keyMaxValidators, func(value string) (interface{}, error) {
+		var v uint32
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}, func(v interface{}) error {
+		if v.(uint32) == 0 {
+			return fmt.Errorf("max validators must be positive")
+		}
+		return nil
+	})
+}